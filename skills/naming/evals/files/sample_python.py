@@ -0,0 +1,27 @@
+import json
+from http import HTTPStatus
+
+
+class UMgr:
+    def __init__(self, db, cfg):
+        self.db = db
+        self.cfg = cfg
+
+    def Proc(self, req):
+        d = json.loads(req.body)
+
+        n = d["n"]
+        e = d["e"]
+        active = True
+
+        try:
+            u = self.db.get_by_email(e)
+        except Exception:
+            return {"status": HTTPStatus.INTERNAL_SERVER_ERROR, "body": "bad"}
+
+        if u is not None:
+            return {"status": HTTPStatus.CONFLICT, "body": "exists"}
+
+        nu = {"Name": n, "Email": e, "Active": active}
+        self.db.save(nu)
+        return {"status": HTTPStatus.OK, "body": json.dumps(nu)}
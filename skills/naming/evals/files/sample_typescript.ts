@@ -0,0 +1,35 @@
+import type { Request, Response } from "express";
+
+interface IDbConn {
+  getByEmail(e: string): Promise<User | null>;
+  save(u: User): Promise<void>;
+}
+
+export class UMgr {
+  constructor(private db: IDbConn, private cfg: Cfg) {}
+
+  async Proc(req: Request, res: Response): Promise<void> {
+    const d = req.body as Record<string, unknown>;
+
+    const n = d["n"] as string;
+    const e = d["e"] as string;
+    const active = true;
+
+    let u: User | null;
+    try {
+      u = await this.db.getByEmail(e);
+    } catch {
+      res.status(500).send("bad");
+      return;
+    }
+
+    if (u !== null) {
+      res.status(409).send("exists");
+      return;
+    }
+
+    const nu: User = { name: n, email: e, active };
+    await this.db.save(nu);
+    res.json(nu);
+  }
+}